@@ -0,0 +1,176 @@
+//go:build !cgo || ukv_pure
+
+package ukv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	u "github.com/unum-cloud/UKV/golang/internal"
+)
+
+// Level is the pure-Go counterpart of golang/level.Level: it satisfies
+// the same u.DataBase/BackendInterface contract, but talks to
+// github.com/syndtr/goleveldb instead of cgo-linked libukv_leveldb, so
+// it cross-compiles anywhere the Go toolchain does.
+type Level struct {
+	u.DataBase
+}
+
+// reader is implemented by both *leveldb.DB and *leveldb.Snapshot, so
+// UKV_read and UKV_scan can run against either one unmodified.
+type reader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+func CreateDB() Level {
+	var dir string
+
+	backend := u.BackendInterface{
+		UKV_open: func(config string) (interface{}, error) {
+			dir = config
+			return leveldb.OpenFile(config, nil)
+		},
+		UKV_free: func(db interface{}) {
+			db.(*leveldb.DB).Close()
+		},
+		UKV_read: func(db interface{}, keys []uint64) ([][]byte, error) {
+			r := db.(reader)
+			values := make([][]byte, len(keys))
+			for i, key := range keys {
+				value, err := r.Get(encodeKey(key), nil)
+				if err == leveldb.ErrNotFound {
+					values[i] = nil
+					continue
+				}
+				if err != nil {
+					return nil, goError(err)
+				}
+				values[i] = value
+			}
+			return values, nil
+		},
+		UKV_write: func(db interface{}, keys []uint64, values [][]byte) error {
+			batch := new(leveldb.Batch)
+			for i, key := range keys {
+				if values[i] == nil {
+					batch.Delete(encodeKey(key))
+				} else {
+					batch.Put(encodeKey(key), values[i])
+				}
+			}
+			return goError(db.(*leveldb.DB).Write(batch, nil))
+		},
+		UKV_scan: func(db interface{}, start []byte, end []byte, limit int) ([][]byte, [][]byte, bool, error) {
+			r := db.(reader)
+			it := r.NewIterator(&util.Range{Start: start, Limit: end}, nil)
+			defer it.Release()
+
+			// Collect one entry past limit so we can tell whether more
+			// data remains without a second blind Next() call, which
+			// would advance past (and lose) the limit+1'th key. The extra
+			// entry is trimmed below and simply re-scanned on the next
+			// call, since "start" for that call is derived from the last
+			// *returned* key, not the trimmed one.
+			var keys, values [][]byte
+			for it.Next() {
+				keys = append(keys, append([]byte(nil), it.Key()...))
+				values = append(values, append([]byte(nil), it.Value()...))
+				if len(keys) > limit {
+					break
+				}
+			}
+			if err := goError(it.Error()); err != nil {
+				return nil, nil, false, err
+			}
+
+			done := len(keys) <= limit
+			if !done {
+				keys = keys[:limit]
+				values = values[:limit]
+			}
+			return keys, values, done, nil
+		},
+		UKV_snapshot_open: func(db interface{}) (interface{}, error) {
+			return db.(*leveldb.DB).GetSnapshot()
+		},
+		UKV_snapshot_free: func(snap interface{}) {
+			snap.(*leveldb.Snapshot).Release()
+		},
+		UKV_get_property: func(db interface{}, name string) (string, error) {
+			// goleveldb has no property that reports total bytes on
+			// disk directly, so compute it from the data directory
+			// rather than forwarding to a property that isn't numeric.
+			if name == "ukv.disk_usage" {
+				size, err := dirSize(dir)
+				if err != nil {
+					return "", err
+				}
+				return strconv.FormatUint(size, 10), nil
+			}
+			return db.(*leveldb.DB).GetProperty(goleveldbPropertyName(name))
+		},
+		UKV_val_len_missing: 0,
+	}
+
+	return Level{DataBase: u.DataBase{Backend: backend}}
+}
+
+// goleveldbPropertyName maps UKV's backend-agnostic property names onto
+// goleveldb's native "leveldb.*" property namespace. Names with no
+// goleveldb counterpart are passed through unchanged, so goleveldb
+// reports them as not found.
+func goleveldbPropertyName(name string) string {
+	switch {
+	case name == "ukv.stats":
+		return "leveldb.stats"
+	case name == "ukv.iostats":
+		return "leveldb.iostats"
+	case strings.HasPrefix(name, "ukv.num_files_at_level"):
+		level := strings.TrimPrefix(name, "ukv.num_files_at_level")
+		return fmt.Sprintf("leveldb.num-files-at-level%s", level)
+	default:
+		return name
+	}
+}
+
+// dirSize sums the size of every regular file under dir.
+func dirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size, err
+}
+
+func encodeKey(key uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, key)
+	return buf
+}
+
+// goError normalizes goleveldb's errors (including the no-op nil case)
+// so Go callers see the same error surface regardless of which backend
+// they linked against.
+func goError(err error) error {
+	if err == nil || err == leveldb.ErrNotFound {
+		return nil
+	}
+	return err
+}
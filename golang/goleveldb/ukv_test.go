@@ -0,0 +1,21 @@
+//go:build !cgo || ukv_pure
+
+package ukv
+
+import (
+	"testing"
+
+	"github.com/unum-cloud/UKV/golang/ukvtest"
+)
+
+// TestConformance runs the shared backend conformance suite against the
+// pure-Go goleveldb binding.
+func TestConformance(t *testing.T) {
+	db := CreateDB()
+	if err := db.Open(t.TempDir()); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	ukvtest.RunSuite(t, &db.DataBase)
+}
@@ -0,0 +1,51 @@
+package internal
+
+// WriteBatch accumulates Put/Delete operations so they can be
+// committed to a DataBase in a single backend call, rather than one
+// call per key.
+type WriteBatch struct {
+	keys   []ukv_key_t
+	values [][]byte
+}
+
+// NewBatch returns an empty WriteBatch.
+func (db *DataBase) NewBatch() *WriteBatch {
+	return &WriteBatch{}
+}
+
+// Put stages a key/value write.
+func (b *WriteBatch) Put(key uint64, value []byte) {
+	b.keys = append(b.keys, key)
+	b.values = append(b.values, value)
+}
+
+// Delete stages a key removal.
+func (b *WriteBatch) Delete(key uint64) {
+	b.keys = append(b.keys, key)
+	b.values = append(b.values, nil)
+}
+
+// Reset discards all staged operations so the batch can be reused.
+func (b *WriteBatch) Reset() {
+	b.keys = b.keys[:0]
+	b.values = b.values[:0]
+}
+
+// Len reports the number of staged operations.
+func (b *WriteBatch) Len() int {
+	return len(b.keys)
+}
+
+// Commit applies every operation staged in b in a single backend call.
+func (db *DataBase) Commit(b *WriteBatch) error {
+	if b.Len() == 0 {
+		return nil
+	}
+	if err := db.Backend.UKV_write(db.raw, b.keys, b.values); err != nil {
+		return err
+	}
+	for _, value := range b.values {
+		db.bytesWritten += uint64(len(value))
+	}
+	return nil
+}
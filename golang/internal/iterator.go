@@ -0,0 +1,160 @@
+package internal
+
+// iteratorWindow is the number of keys fetched per ukv_scan call. Each
+// refill replaces the previously loaded window, so scanning a huge
+// range never keeps more than one window resident at a time.
+const iteratorWindow = 256
+
+// Iterator walks a contiguous key range of a DataBase, refilling its
+// arena in windows of iteratorWindow keys via the backend's scan entry
+// point. It is not safe for concurrent use.
+//
+// Prev only steps back within the currently loaded window: once a
+// window has been discarded to make room for the next one, the keys in
+// it are gone for good. A caller that scans forward past a window
+// boundary and then calls Prev enough times to want to cross back over
+// it will hit the window's start and get false, not the earlier key —
+// Seek again instead of relying on Prev to walk arbitrarily far back.
+type Iterator struct {
+	db     *DataBase
+	start  []byte
+	end    []byte
+	prefix []byte
+
+	keys   [][]byte
+	values [][]byte
+	pos    int
+	done   bool
+	err    error
+}
+
+// NewIterator returns an Iterator over [start, limit).
+func (db *DataBase) NewIterator(start []byte, limit []byte) *Iterator {
+	return &Iterator{db: db, start: start, end: limit}
+}
+
+// NewIteratorWithPrefix returns an Iterator over every key sharing prefix.
+func (db *DataBase) NewIteratorWithPrefix(prefix []byte) *Iterator {
+	return &Iterator{db: db, start: prefix, prefix: prefix}
+}
+
+// Seek repositions the iterator at the first key >= target and refills
+// its window from there.
+func (it *Iterator) Seek(target []byte) {
+	it.start = target
+	it.keys = nil
+	it.values = nil
+	it.pos = -1
+	it.done = false
+	it.err = nil
+	it.fill()
+}
+
+// fill refills the current window and rewinds pos to -1, so the
+// caller's next Next() call lands on the window's first entry.
+func (it *Iterator) fill() {
+	if it.done || it.err != nil {
+		return
+	}
+	keys, values, done, err := it.db.Backend.UKV_scan(it.db.raw, it.start, it.end, iteratorWindow)
+	if err != nil {
+		it.err = err
+		return
+	}
+	it.keys, it.values, it.pos = keys, values, -1
+	if len(keys) > 0 {
+		it.start = nextKey(keys[len(keys)-1])
+	}
+	it.done = done
+}
+
+func (it *Iterator) inPrefix(key []byte) bool {
+	if it.prefix == nil {
+		return true
+	}
+	if len(key) < len(it.prefix) {
+		return false
+	}
+	for i := range it.prefix {
+		if key[i] != it.prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Next advances the iterator, refilling its window when exhausted. It
+// reports whether a valid entry is now available.
+func (it *Iterator) Next() bool {
+	if it.keys == nil && !it.done {
+		it.fill()
+	}
+	if it.err != nil {
+		return false
+	}
+	it.pos++
+	for it.pos >= len(it.keys) {
+		if it.done {
+			return false
+		}
+		it.fill()
+		if it.err != nil || len(it.keys) == 0 {
+			return false
+		}
+		it.pos = 0
+	}
+	if !it.inPrefix(it.keys[it.pos]) {
+		it.done = true
+		return false
+	}
+	return true
+}
+
+// Prev steps back within the currently loaded window. Stepping before
+// the start of the window requires Seek-ing again.
+func (it *Iterator) Prev() bool {
+	if it.pos <= 0 {
+		return false
+	}
+	it.pos--
+	return true
+}
+
+// Key returns the key at the iterator's current position.
+func (it *Iterator) Key() []byte {
+	if it.pos < 0 || it.pos >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.pos]
+}
+
+// Value returns the value at the iterator's current position.
+func (it *Iterator) Value() []byte {
+	if it.pos < 0 || it.pos >= len(it.values) {
+		return nil
+	}
+	return it.values[it.pos]
+}
+
+// Release frees the iterator's arena.
+func (it *Iterator) Release() {
+	it.keys = nil
+	it.values = nil
+}
+
+// Error returns the first error encountered while scanning, if any.
+func (it *Iterator) Error() error {
+	return it.err
+}
+
+func nextKey(key []byte) []byte {
+	next := make([]byte, len(key))
+	copy(next, key)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next
+		}
+	}
+	return append(next, 0)
+}
@@ -0,0 +1,39 @@
+package internal
+
+// Snapshot is a consistent, point-in-time view of a DataBase that is
+// unaffected by concurrent writers. It must be Release()d once done.
+type Snapshot struct {
+	db  *DataBase
+	raw interface{}
+}
+
+// Snapshot opens a new consistent view of the database.
+func (db *DataBase) Snapshot() (*Snapshot, error) {
+	raw, err := db.Backend.UKV_snapshot_open(db.raw)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{db: db, raw: raw}, nil
+}
+
+// Get fetches the value stored under key as of the snapshot.
+func (s *Snapshot) Get(key uint64) ([]byte, error) {
+	values, err := s.db.Backend.UKV_read(s.raw, []ukv_key_t{key})
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// NewIterator returns an Iterator over [start, limit) pinned to the snapshot.
+func (s *Snapshot) NewIterator(start []byte, limit []byte) *Iterator {
+	return &Iterator{db: &DataBase{Backend: s.db.Backend, raw: s.raw}, start: start, end: limit}
+}
+
+// Release frees the snapshot handle.
+func (s *Snapshot) Release() {
+	if s.raw != nil {
+		s.db.Backend.UKV_snapshot_free(s.raw)
+		s.raw = nil
+	}
+}
@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// maxLevels bounds how many "ukv.num_files_at_level{N}" properties
+// Stats will probe. LevelDB-style engines never use more than a
+// handful of levels, and a backend's GetProperty has no reliable way
+// to signal "past the last level" (goleveldb, for one, just reports 0
+// for any out-of-range level), so an unbounded probe loop never
+// terminates.
+const maxLevels = 7
+
+// Stats is a parsed, typed snapshot of a DataBase's engine and iostats
+// properties, suitable for exporting to Prometheus.
+type Stats struct {
+	BytesRead       uint64
+	BytesWritten    uint64
+	DiskUsage       uint64
+	LevelFileCounts []uint64
+}
+
+// GetProperty forwards name to the backend's native property accessor.
+// Well-known names include "ukv.stats", "ukv.iostats",
+// "ukv.num_files_at_level{N}", "ukv.disk_usage" and "ukv.memory_usage".
+func (db *DataBase) GetProperty(name string) (string, error) {
+	return db.Backend.UKV_get_property(db.raw, name)
+}
+
+// Stats summarizes the database's disk usage and per-level file counts
+// alongside the byte counters tracked around Get/Set/Commit.
+func (db *DataBase) Stats() (Stats, error) {
+	stats := Stats{
+		BytesRead:    db.bytesRead,
+		BytesWritten: db.bytesWritten,
+	}
+
+	if v, err := db.GetProperty("ukv.disk_usage"); err == nil {
+		size, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return stats, fmt.Errorf("stats: parsing ukv.disk_usage %q: %w", v, err)
+		}
+		stats.DiskUsage = size
+	}
+
+	for level := 0; level < maxLevels; level++ {
+		v, err := db.GetProperty(fmt.Sprintf("ukv.num_files_at_level%d", level))
+		if err != nil || v == "" {
+			break
+		}
+		count, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			break
+		}
+		stats.LevelFileCounts = append(stats.LevelFileCounts, count)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,90 @@
+package internal
+
+// ukv_length_t mirrors the C `ukv_length_t` typedef used to report
+// value lengths and offsets across the UKV C ABI.
+type ukv_length_t = uint32
+
+// ukv_key_t mirrors the C `ukv_key_t` typedef.
+type ukv_key_t = uint64
+
+// BackendInterface collects the primitive operations a concrete UKV
+// backend must provide. DataBase, Iterator, Snapshot and WriteBatch are
+// all implemented purely in terms of this interface, so a new backend
+// only needs to populate one of these structs to be usable everywhere
+// else in the Go bindings.
+type BackendInterface struct {
+	UKV_error_free func(error interface{})
+	UKV_arena_free func(arena interface{})
+
+	UKV_open func(config string) (interface{}, error)
+	UKV_free func(db interface{})
+
+	UKV_read  func(db interface{}, keys []ukv_key_t) ([][]byte, error)
+	UKV_write func(db interface{}, keys []ukv_key_t, values [][]byte) error
+
+	// UKV_scan fetches up to `limit` keys starting at `start`, returning a
+	// window of the result so large ranges can be paged through without
+	// materializing the whole scan in memory.
+	UKV_scan func(db interface{}, start []byte, end []byte, limit int) (keys [][]byte, values [][]byte, done bool, err error)
+
+	UKV_snapshot_open func(db interface{}) (interface{}, error)
+	UKV_snapshot_free func(snapshot interface{})
+
+	// UKV_get_property forwards a well-known property name (e.g.
+	// "ukv.stats", "ukv.disk_usage") to the backend's native accessor.
+	UKV_get_property func(db interface{}, name string) (string, error)
+
+	UKV_val_len_missing ukv_length_t
+}
+
+// DataBase is the shared, backend-agnostic wrapper every Go binding
+// (cgo-based or pure-Go) builds its public type around.
+type DataBase struct {
+	Backend BackendInterface
+	raw     interface{}
+
+	bytesRead    uint64
+	bytesWritten uint64
+}
+
+// Open initializes the underlying backend with the given config string.
+func (db *DataBase) Open(config string) error {
+	raw, err := db.Backend.UKV_open(config)
+	if err != nil {
+		return err
+	}
+	db.raw = raw
+	return nil
+}
+
+// Close releases the underlying backend handle.
+func (db *DataBase) Close() {
+	if db.raw != nil {
+		db.Backend.UKV_free(db.raw)
+		db.raw = nil
+	}
+}
+
+// Get fetches the value stored under key.
+func (db *DataBase) Get(key uint64) ([]byte, error) {
+	values, err := db.Backend.UKV_read(db.raw, []ukv_key_t{key})
+	if err != nil {
+		return nil, err
+	}
+	db.bytesRead += uint64(len(values[0]))
+	return values[0], nil
+}
+
+// Set stores value under key.
+func (db *DataBase) Set(key uint64, value []byte) error {
+	if err := db.Backend.UKV_write(db.raw, []ukv_key_t{key}, [][]byte{value}); err != nil {
+		return err
+	}
+	db.bytesWritten += uint64(len(value))
+	return nil
+}
+
+// Delete removes key.
+func (db *DataBase) Delete(key uint64) error {
+	return db.Backend.UKV_write(db.raw, []ukv_key_t{key}, [][]byte{nil})
+}
@@ -24,6 +24,10 @@ func CreateDB() Level {
 		UKV_free:            C.ukv_database_free,
 		UKV_read:            C.ukv_read,
 		UKV_write:           C.ukv_write,
+		UKV_scan:            C.ukv_scan,
+		UKV_snapshot_open:   C.ukv_snapshot_open,
+		UKV_snapshot_free:   C.ukv_snapshot_free,
+		UKV_get_property:    C.ukv_get_property,
 		UKV_val_len_missing: u.ukv_length_t(C.ukv_length_missing_k)}
 
 	db := Level{DataBase: u.DataBase{Backend: backend}}
@@ -0,0 +1,47 @@
+package ukv
+
+import "testing"
+
+func BenchmarkPutOneByOne(b *testing.B) {
+	db := CreateDB()
+	if err := db.Open(b.TempDir()); err != nil {
+		b.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	value := []byte("benchmark-value")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := db.Set(uint64(i), value); err != nil {
+			b.Fatalf("Set: %v", err)
+		}
+	}
+}
+
+func BenchmarkPutBatch(b *testing.B) {
+	db := CreateDB()
+	if err := db.Open(b.TempDir()); err != nil {
+		b.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	value := []byte("benchmark-value")
+	const batchSize = 256
+
+	b.ResetTimer()
+	batch := db.NewBatch()
+	for i := 0; i < b.N; i++ {
+		batch.Put(uint64(i), value)
+		if batch.Len() == batchSize {
+			if err := db.Commit(batch); err != nil {
+				b.Fatalf("Commit: %v", err)
+			}
+			batch.Reset()
+		}
+	}
+	if batch.Len() > 0 {
+		if err := db.Commit(batch); err != nil {
+			b.Fatalf("Commit: %v", err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package ukv
+
+import "testing"
+
+func TestStatsTracksIOBytes(t *testing.T) {
+	db := CreateDB()
+	if err := db.Open(t.TempDir()); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Set(1, []byte("value")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if _, err := db.Get(1); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	stats, err := db.Stats()
+	if err != nil {
+		t.Fatalf("Stats: %v", err)
+	}
+	if stats.BytesWritten == 0 {
+		t.Fatalf("BytesWritten = 0, want > 0")
+	}
+	if stats.BytesRead == 0 {
+		t.Fatalf("BytesRead = 0, want > 0")
+	}
+}
@@ -0,0 +1,45 @@
+package ukv
+
+import "testing"
+
+// TestSnapshotIsolatesConcurrentWrites checks that a snapshot keeps
+// returning the value it saw at open time even after the underlying
+// key is overwritten by a later write.
+func TestSnapshotIsolatesConcurrentWrites(t *testing.T) {
+	db := CreateDB()
+	if err := db.Open(""); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	const key = uint64(1)
+	if err := db.Set(key, []byte("before")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	snap, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+	defer snap.Release()
+
+	if err := db.Set(key, []byte("after")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	got, err := snap.Get(key)
+	if err != nil {
+		t.Fatalf("snap.Get: %v", err)
+	}
+	if string(got) != "before" {
+		t.Fatalf("snapshot read saw concurrent write: got %q, want %q", got, "before")
+	}
+
+	got, err = db.Get(key)
+	if err != nil {
+		t.Fatalf("db.Get: %v", err)
+	}
+	if string(got) != "after" {
+		t.Fatalf("live read did not see latest write: got %q, want %q", got, "after")
+	}
+}
@@ -0,0 +1,77 @@
+package shelf
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec converts a Go value to and from the byte representation stored
+// in the underlying UKV database.
+type Codec[T any] interface {
+	Encode(T) ([]byte, error)
+	Decode([]byte) (T, error)
+}
+
+// GobCodec encodes values with encoding/gob.
+type GobCodec[T any] struct{}
+
+func (GobCodec[T]) Encode(v T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (GobCodec[T]) Decode(data []byte) (T, error) {
+	var v T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v)
+	return v, err
+}
+
+// JSONCodec encodes values with encoding/json.
+type JSONCodec[T any] struct{}
+
+func (JSONCodec[T]) Encode(v T) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec[T]) Decode(data []byte) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
+
+// ProtoCodec encodes values with Protocol Buffers. T must be a pointer
+// to a generated message type.
+type ProtoCodec[T proto.Message] struct {
+	// New returns a fresh, empty instance of T for Decode to populate.
+	New func() T
+}
+
+func (c ProtoCodec[T]) Encode(v T) ([]byte, error) {
+	return proto.Marshal(v)
+}
+
+func (c ProtoCodec[T]) Decode(data []byte) (T, error) {
+	v := c.New()
+	if err := proto.Unmarshal(data, v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+// RawBytesCodec is the identity codec for []byte values.
+type RawBytesCodec struct{}
+
+func (RawBytesCodec) Encode(v []byte) ([]byte, error) {
+	return v, nil
+}
+
+func (RawBytesCodec) Decode(data []byte) ([]byte, error) {
+	return data, nil
+}
@@ -0,0 +1,216 @@
+// Package shelf layers a typed, generic key/value store on top of any
+// byte-level u.DataBase, in the spirit of go-shelve: callers pick
+// key/value codecs instead of hand-rolling (de)serialization around
+// the raw UKV bindings.
+package shelf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+
+	u "github.com/unum-cloud/UKV/golang/internal"
+)
+
+// ErrKeyCollision is returned when a key hashes to an underlying UKV key
+// that already holds a record for something else: another key of the
+// same collection, a key of another collection, or data that was not
+// written by a shelf. ukvKey folds an
+// arbitrary key down to a single uint64, so collisions are rare but
+// possible at scale; every method that reads or overwrites a slot
+// checks the record it finds there actually belongs to the requested
+// key rather than trusting the hash alone.
+var ErrKeyCollision = errors.New("shelf: hash collision between distinct keys in the same collection")
+
+// Options configures a Shelf: how keys and values are (de)serialized,
+// and which collection namespace they live under.
+type Options[K, V any] struct {
+	KeyCodec   Codec[K]
+	ValueCodec Codec[V]
+
+	// Collection namespaces this shelf's keys so several shelves can
+	// share one underlying DataBase without colliding.
+	Collection string
+}
+
+// Shelf is a typed view over a u.DataBase.
+type Shelf[K, V any] struct {
+	db   *u.DataBase
+	opts Options[K, V]
+}
+
+// Open returns a Shelf backed by db, using the codecs and collection
+// name in opts.
+func Open[K, V any](db *u.DataBase, opts Options[K, V]) (*Shelf[K, V], error) {
+	if opts.KeyCodec == nil || opts.ValueCodec == nil {
+		return nil, errors.New("shelf: KeyCodec and ValueCodec are required")
+	}
+	return &Shelf[K, V]{db: db, opts: opts}, nil
+}
+
+// Put stores value under key, overwriting any existing entry. It
+// returns ErrKeyCollision instead of overwriting if the slot key hashes
+// to already holds a record for a different key.
+func (s *Shelf[K, V]) Put(key K, value V) error {
+	keyBytes, err := s.opts.KeyCodec.Encode(key)
+	if err != nil {
+		return err
+	}
+	if err := s.checkSlotOwnedBy(keyBytes); err != nil {
+		return err
+	}
+	valueBytes, err := s.opts.ValueCodec.Encode(value)
+	if err != nil {
+		return err
+	}
+	return s.db.Set(s.ukvKey(keyBytes), s.packRecord(keyBytes, valueBytes))
+}
+
+// Get fetches the value stored under key. The second return value is
+// false if no entry exists. It returns ErrKeyCollision if key's slot
+// holds a record for a different key.
+func (s *Shelf[K, V]) Get(key K) (V, bool, error) {
+	var zero V
+	keyBytes, err := s.opts.KeyCodec.Encode(key)
+	if err != nil {
+		return zero, false, err
+	}
+	raw, err := s.db.Get(s.ukvKey(keyBytes))
+	if err != nil {
+		return zero, false, err
+	}
+	if raw == nil {
+		return zero, false, nil
+	}
+	existingKeyBytes, valueBytes, ok := s.unpackRecord(raw)
+	if !ok {
+		return zero, false, ErrKeyCollision
+	}
+	if !bytes.Equal(existingKeyBytes, keyBytes) {
+		return zero, false, ErrKeyCollision
+	}
+	value, err := s.opts.ValueCodec.Decode(valueBytes)
+	if err != nil {
+		return zero, false, err
+	}
+	return value, true, nil
+}
+
+// Delete removes key, if present. It returns ErrKeyCollision, without
+// deleting anything, if key's slot holds a record for a different key.
+func (s *Shelf[K, V]) Delete(key K) error {
+	keyBytes, err := s.opts.KeyCodec.Encode(key)
+	if err != nil {
+		return err
+	}
+	if err := s.checkSlotOwnedBy(keyBytes); err != nil {
+		return err
+	}
+	return s.db.Delete(s.ukvKey(keyBytes))
+}
+
+// checkSlotOwnedBy returns ErrKeyCollision if the UKV slot keyBytes
+// hashes to already holds a record for a different key. A missing slot
+// or a slot already owned by keyBytes are both fine.
+func (s *Shelf[K, V]) checkSlotOwnedBy(keyBytes []byte) error {
+	raw, err := s.db.Get(s.ukvKey(keyBytes))
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+	existingKeyBytes, _, ok := s.unpackRecord(raw)
+	if !ok {
+		return ErrKeyCollision
+	}
+	if !bytes.Equal(existingKeyBytes, keyBytes) {
+		return ErrKeyCollision
+	}
+	return nil
+}
+
+// Range calls fn for every entry in the shelf, stopping early if fn
+// returns false. Iteration order is the underlying DataBase's key
+// order, not insertion order.
+func (s *Shelf[K, V]) Range(fn func(K, V) bool) error {
+	it := s.db.NewIterator(nil, nil)
+	defer it.Release()
+
+	for it.Next() {
+		keyBytes, valueBytes, ok := s.unpackRecord(it.Value())
+		if !ok {
+			continue
+		}
+		key, err := s.opts.KeyCodec.Decode(keyBytes)
+		if err != nil {
+			continue
+		}
+		value, err := s.opts.ValueCodec.Decode(valueBytes)
+		if err != nil {
+			continue
+		}
+		if !fn(key, value) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// ukvKey derives the uint64 UKV key a record is stored under, scoping
+// it to this shelf's collection so equal keys in distinct shelves over
+// the same DataBase land in different slots. The result is a 64-bit
+// hash, so distinct keys can still share a slot; see ErrKeyCollision.
+func (s *Shelf[K, V]) ukvKey(keyBytes []byte) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s.opts.Collection))
+	h.Write([]byte{0}) // separator, so "ab"+"c" != "a"+"bc"
+	h.Write(keyBytes)
+	return h.Sum64()
+}
+
+// packRecord bundles the collection tag and original key alongside the
+// value so Range can recover (K, V) pairs without inverting ukvKey's
+// hash.
+func (s *Shelf[K, V]) packRecord(keyBytes, valueBytes []byte) []byte {
+	collection := []byte(s.opts.Collection)
+	record := make([]byte, 0, 4+len(collection)+4+len(keyBytes)+len(valueBytes))
+	record = appendUint32Prefixed(record, collection)
+	record = appendUint32Prefixed(record, keyBytes)
+	record = append(record, valueBytes...)
+	return record
+}
+
+// unpackRecord reverses packRecord, returning ok=false if record does
+// not belong to this shelf's collection.
+func (s *Shelf[K, V]) unpackRecord(record []byte) (keyBytes, valueBytes []byte, ok bool) {
+	collection, rest, ok := readUint32Prefixed(record)
+	if !ok || string(collection) != s.opts.Collection {
+		return nil, nil, false
+	}
+	keyBytes, rest, ok = readUint32Prefixed(rest)
+	if !ok {
+		return nil, nil, false
+	}
+	return keyBytes, rest, true
+}
+
+func appendUint32Prefixed(dst, data []byte) []byte {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	dst = append(dst, length[:]...)
+	return append(dst, data...)
+}
+
+func readUint32Prefixed(data []byte) (field, rest []byte, ok bool) {
+	if len(data) < 4 {
+		return nil, nil, false
+	}
+	length := binary.BigEndian.Uint32(data[:4])
+	data = data[4:]
+	if uint32(len(data)) < length {
+		return nil, nil, false
+	}
+	return data[:length], data[length:], true
+}
@@ -0,0 +1,89 @@
+//go:build !cgo || ukv_pure
+
+package shelf
+
+import (
+	"testing"
+
+	goleveldb "github.com/unum-cloud/UKV/golang/goleveldb"
+)
+
+func TestShelfPutGetDelete(t *testing.T) {
+	raw := goleveldb.CreateDB()
+	if err := raw.Open(t.TempDir()); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer raw.Close()
+
+	s, err := Open[string, int](&raw.DataBase, Options[string, int]{
+		KeyCodec:   JSONCodec[string]{},
+		ValueCodec: JSONCodec[int]{},
+		Collection: "counters",
+	})
+	if err != nil {
+		t.Fatalf("Open shelf: %v", err)
+	}
+
+	if err := s.Put("visits", 42); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, ok, err := s.Get("visits")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !ok || got != 42 {
+		t.Fatalf("Get = (%d, %v), want (42, true)", got, ok)
+	}
+
+	if err := s.Delete("visits"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok, err := s.Get("visits"); err != nil || ok {
+		t.Fatalf("Get after Delete = (_, %v, %v), want (_, false, nil)", ok, err)
+	}
+}
+
+func TestShelfRangeIsolatesCollections(t *testing.T) {
+	raw := goleveldb.CreateDB()
+	if err := raw.Open(t.TempDir()); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer raw.Close()
+
+	users, err := Open[string, string](&raw.DataBase, Options[string, string]{
+		KeyCodec:   JSONCodec[string]{},
+		ValueCodec: JSONCodec[string]{},
+		Collection: "users",
+	})
+	if err != nil {
+		t.Fatalf("Open users shelf: %v", err)
+	}
+	orders, err := Open[string, string](&raw.DataBase, Options[string, string]{
+		KeyCodec:   JSONCodec[string]{},
+		ValueCodec: JSONCodec[string]{},
+		Collection: "orders",
+	})
+	if err != nil {
+		t.Fatalf("Open orders shelf: %v", err)
+	}
+
+	if err := users.Put("alice", "admin"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := orders.Put("alice", "pending"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	seen := map[string]string{}
+	if err := users.Range(func(k, v string) bool {
+		seen[k] = v
+		return true
+	}); err != nil {
+		t.Fatalf("Range: %v", err)
+	}
+
+	if len(seen) != 1 || seen["alice"] != "admin" {
+		t.Fatalf("users.Range leaked across collections: %v", seen)
+	}
+}
@@ -0,0 +1,112 @@
+// Package ukvtest holds a backend-agnostic conformance suite that every
+// Go UKV binding (cgo-based or pure-Go) runs against, so the two stay
+// behaviorally aligned as they evolve independently.
+package ukvtest
+
+import (
+	"encoding/binary"
+	"testing"
+
+	u "github.com/unum-cloud/UKV/golang/internal"
+)
+
+// RunSuite exercises db against the behaviors every backend must share:
+// basic Get/Set/Delete, range scanning and snapshot isolation. db must
+// already be Open()'d by the caller; RunSuite does not close it.
+func RunSuite(t *testing.T, db *u.DataBase) {
+	t.Run("GetSetDelete", func(t *testing.T) { testGetSetDelete(t, db) })
+	t.Run("Scan", func(t *testing.T) { testScan(t, db) })
+	t.Run("Snapshot", func(t *testing.T) { testSnapshot(t, db) })
+}
+
+func testGetSetDelete(t *testing.T, db *u.DataBase) {
+	if err := db.Set(1, []byte("one")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	got, err := db.Get(1)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if string(got) != "one" {
+		t.Fatalf("Get = %q, want %q", got, "one")
+	}
+	if err := db.Delete(1); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	got, _ = db.Get(1)
+	if got != nil {
+		t.Fatalf("Get after Delete = %q, want nil", got)
+	}
+}
+
+// scanWindowKeys exceeds the backends' internal scan window (256 keys),
+// so this test actually exercises paging across a window boundary
+// instead of fitting entirely inside the first page.
+const scanWindowKeys = 300
+
+func testScan(t *testing.T, db *u.DataBase) {
+	const base = uint64(1_000_000)
+	want := make(map[uint64][]byte, scanWindowKeys)
+	for i := uint64(0); i < scanWindowKeys; i++ {
+		key := base + i
+		value := []byte{byte(i), byte(i >> 8)}
+		if err := db.Set(key, value); err != nil {
+			t.Fatalf("Set(%d): %v", key, err)
+		}
+		want[key] = value
+	}
+
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+
+	got := make(map[uint64][]byte, scanWindowKeys)
+	for it.Next() {
+		if len(it.Key()) != 8 {
+			continue
+		}
+		key := binary.BigEndian.Uint64(it.Key())
+		if key < base || key >= base+scanWindowKeys {
+			continue // key from an earlier subtest
+		}
+		got[key] = append([]byte(nil), it.Value()...)
+	}
+	if err := it.Error(); err != nil {
+		t.Fatalf("iterator error: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("scanned %d keys across the window boundary, want %d", len(got), len(want))
+	}
+	for key, value := range want {
+		gotValue, ok := got[key]
+		if !ok {
+			t.Fatalf("missing key %d from scan (dropped at a window boundary?)", key)
+		}
+		if string(gotValue) != string(value) {
+			t.Fatalf("key %d: got %q, want %q", key, gotValue, value)
+		}
+	}
+}
+
+func testSnapshot(t *testing.T, db *u.DataBase) {
+	if err := db.Set(20, []byte("before")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	snap, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+	defer snap.Release()
+
+	if err := db.Set(20, []byte("after")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	got, err := snap.Get(20)
+	if err != nil {
+		t.Fatalf("snap.Get: %v", err)
+	}
+	if string(got) != "before" {
+		t.Fatalf("snap.Get = %q, want %q", got, "before")
+	}
+}